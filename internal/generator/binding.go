@@ -33,20 +33,35 @@ type Binding struct {
 	Entities []*Entity
 
 	currentEntityName string
+	structTypes       map[string]*ast.StructType // named struct types declared in the file, keyed by name
+	embeddedOnly      map[string]bool            // type names only ever used as embedded/inline fields, never a standalone entity
 	err               error
 }
 
 type Entity struct {
-	Name           string
-	Id             id
-	Uid            uid
-	Properties     []*Property
-	IdProperty     *Property
-	LastPropertyId modelinfo.IdUid
+	Name               string
+	Id                 id
+	Uid                uid
+	Properties         []*Property
+	IdProperty         *Property
+	CompositeIndexes   []*CompositeIndex
+	FullTextProperties []*Property
+	LastPropertyId     modelinfo.IdUid
 
 	binding *Binding // parent
 }
 
+// CompositeIndex is a multi-property index declared at the struct level via a
+// blank (`_`) sentinel field, e.g.:
+//
+//	_ struct{} `unique:"Email,TenantId"`
+type CompositeIndex struct {
+	Id         id
+	Uid        uid
+	Unique     bool
+	Properties []*Property
+}
+
 type Property struct {
 	Name        string
 	ObName      string
@@ -59,6 +74,18 @@ type Property struct {
 	FbType      string
 	Relation    *Relation
 	Index       *Index
+	Converter   *Converter
+
+	// DateNano is set when a Date property (backed by a time.Time field or an
+	// explicit `date` annotation) is stored with nanosecond rather than the
+	// default millisecond resolution - see the `date-nano` annotation.
+	DateNano bool
+
+	// Path is the Go field-access path the template uses to read/write this
+	// property's value. For a regular property it's just Name; for a property
+	// flattened out of an embedded/inline nested struct it's dotted, e.g.
+	// "Address.City".
+	Path string
 
 	entity *Entity
 }
@@ -67,6 +94,17 @@ type Relation struct {
 	Target string
 }
 
+// Converter holds the two user-provided function references declared via the
+// `converter:"ToDb,FromDb"` annotation, used to bypass the built-in Go type
+// table for types such as enums, uuid.UUID or decimal.Decimal. The names are
+// emitted into the template as-is (e.g. "pkg.ToDb") - they must already be
+// reachable from the generated file, typically because the entity file itself
+// imports the package.
+type Converter struct {
+	ToDb   string
+	FromDb string
+}
+
 type Index struct {
 	Id  id
 	Uid uid
@@ -83,7 +121,19 @@ func newBinding() (*Binding, error) {
 func (binding *Binding) createFromAst(f *file) (err error) {
 	binding.Package = f.f.Name.Name // this is actually package name, not file name
 
-	// process all structs
+	// first pass: collect all named struct types declared in the file so that
+	// embedded/inline fields can be resolved regardless of declaration order
+	binding.structTypes = make(map[string]*ast.StructType)
+	f.walk(func(node ast.Node) bool {
+		return binding.structTypeCollector(node)
+	})
+	binding.currentEntityName = ""
+
+	// a type referenced as an embedded/inline field anywhere in the file exists
+	// purely to be flattened - it must not also be generated as its own entity
+	binding.embeddedOnly = binding.findEmbeddedOnlyTypes()
+
+	// second pass: process all structs as entities, skipping embed/inline-only types
 	f.walk(func(node ast.Node) bool {
 		return binding.entityLoader(node)
 	})
@@ -111,6 +161,9 @@ func (binding *Binding) entityLoader(node ast.Node) bool {
 			// NOTE this should probably not happen
 			binding.err = fmt.Errorf("encountered a struct without a name")
 			return false
+		} else if binding.embeddedOnly[binding.currentEntityName] {
+			// this struct exists purely to be embedded/inlined elsewhere, not a standalone entity
+			binding.currentEntityName = ""
 		} else {
 			binding.err = binding.createEntityFromAst(node)
 			// reset after it has been "consumed"
@@ -127,6 +180,123 @@ func (binding *Binding) entityLoader(node ast.Node) bool {
 	return false
 }
 
+// findEmbeddedOnlyTypes scans every struct type collected in binding.structTypes
+// for anonymous/inline/flatten fields and returns the set of type names they
+// reference - those types are flattened into their parent and must not also
+// become standalone entities.
+func (binding *Binding) findEmbeddedOnlyTypes() map[string]bool {
+	var referenced = make(map[string]bool)
+
+	for _, structType := range binding.structTypes {
+		for _, f := range structType.Fields.List {
+			if len(f.Names) == 0 {
+				if name, ok := embeddedTypeName(f.Type); ok {
+					referenced[name] = true
+				}
+				continue
+			}
+
+			if f.Tag == nil {
+				continue
+			}
+
+			var tagProperty = &Property{}
+			if err := tagProperty.setAnnotations(f.Tag.Value); err != nil {
+				continue // malformed tags are reported properly once the entity is actually processed
+			}
+
+			if tagProperty.Annotations["inline"] != nil || tagProperty.Annotations["flatten"] != nil {
+				if name, ok := embeddedTypeName(f.Type); ok {
+					referenced[name] = true
+				}
+			}
+		}
+	}
+
+	return referenced
+}
+
+// structTypeCollector mirrors entityLoader's traversal but only registers the
+// named struct types it finds, it doesn't create entities for them - a type
+// only becomes an entity if entityLoader's own pass reaches it.
+func (binding *Binding) structTypeCollector(node ast.Node) bool {
+	switch v := node.(type) {
+	case *ast.TypeSpec:
+		binding.currentEntityName = v.Name.Name
+		return true
+	case *ast.StructType:
+		if binding.currentEntityName != "" {
+			binding.structTypes[binding.currentEntityName] = v
+			binding.currentEntityName = ""
+		}
+		return true
+	case *ast.GenDecl:
+		return true
+	case *ast.File:
+		return true
+	}
+
+	return false
+}
+
+// isEmptyStructType reports whether t is the literal `struct{}` type, used to
+// recognize blank sentinel fields that carry a struct-level annotation.
+func isEmptyStructType(t ast.Expr) bool {
+	s, ok := t.(*ast.StructType)
+	return ok && s.Fields != nil && len(s.Fields.List) == 0
+}
+
+// findPropertyByName looks up a property by its fully qualified ObName (e.g.
+// "home.city" for a flattened nested property), matching case-insensitively
+// like ObjectBox core does internally. As a convenience, a bare Go field name
+// is also accepted, matched against the field names of all properties
+// (nested ones included), but only if it's unambiguous - ambiguous bare names (e.g. two
+// embedded structs both contributing a "City" property) are rejected rather
+// than silently resolved to the first match.
+func (entity *Entity) findPropertyByName(name string) (*Property, error) {
+	var lowerName = strings.ToLower(name)
+
+	for _, property := range entity.Properties {
+		if strings.ToLower(property.ObName) == lowerName {
+			return property, nil
+		}
+	}
+
+	var matches []*Property
+	for _, property := range entity.Properties {
+		if strings.ToLower(property.Name) == lowerName {
+			matches = append(matches, property)
+		}
+	}
+
+	if len(matches) > 1 {
+		return nil, fmt.Errorf("property name %s is ambiguous on entity %s - use the fully qualified name (e.g. %s)",
+			name, entity.Name, matches[0].ObName)
+	}
+
+	if len(matches) == 1 {
+		return matches[0], nil
+	}
+
+	return nil, nil
+}
+
+// embeddedTypeName returns the name of the struct type referenced by t, if
+// any, e.g. "Address" for both `Address` and `*Address` field types. It
+// doesn't verify the type is actually a struct - that's checked on lookup.
+func embeddedTypeName(t ast.Expr) (string, bool) {
+	switch e := t.(type) {
+	case *ast.Ident:
+		return e.Name, true
+	case *ast.StarExpr:
+		return embeddedTypeName(e.X)
+	case *ast.SelectorExpr:
+		return e.Sel.Name, true
+	default:
+		return "", false
+	}
+}
+
 func (binding *Binding) createEntityFromAst(node ast.Node) (err error) {
 	entity := &Entity{
 		binding: binding,
@@ -139,17 +309,60 @@ func (binding *Binding) createEntityFromAst(node ast.Node) (err error) {
 		return fmt.Errorf("%s on property %s, entity %s", err, property.Name, entity.Name)
 	}
 
+	type compositeIndexDirective struct {
+		unique        bool
+		propertyNames []string
+	}
+	var compositeIndexDirectives []compositeIndexDirective
+
 	switch t := node.(type) {
 	case *ast.StructType:
 		for _, f := range t.Fields.List {
-			if len(f.Names) != 1 {
+			// a blank sentinel field declares a struct-level composite index, e.g.
+			// `_ struct{} `unique:"Email,TenantId"``, it's not a property itself
+			if len(f.Names) == 1 && f.Names[0].Name == "_" && isEmptyStructType(f.Type) {
+				if f.Tag == nil {
+					continue
+				}
+				var directive = &Property{}
+				if err = directive.setAnnotations(f.Tag.Value); err != nil {
+					return fmt.Errorf("%s on a composite index directive, entity %s", err, entity.Name)
+				}
+				if unique := directive.Annotations["unique"]; unique != nil {
+					compositeIndexDirectives = append(compositeIndexDirectives, compositeIndexDirective{
+						unique:        true,
+						propertyNames: strings.Split(unique.Value, ","),
+					})
+				}
+				if index := directive.Annotations["index"]; index != nil {
+					compositeIndexDirectives = append(compositeIndexDirectives, compositeIndexDirective{
+						unique:        false,
+						propertyNames: strings.Split(index.Value, ","),
+					})
+				}
+				continue
+			}
+
+			var isEmbedded = len(f.Names) == 0
+			var fieldName string
+
+			if isEmbedded {
+				name, ok := embeddedTypeName(f.Type)
+				if !ok {
+					return fmt.Errorf("struct %s has an anonymous field of an unsupported type", entity.Name)
+				}
+				fieldName = name
+			} else if len(f.Names) == 1 {
+				fieldName = f.Names[0].Name
+			} else {
 				return fmt.Errorf("struct %s has a f with an invalid number of names, one expected, got %v",
 					entity.Name, len(f.Names))
 			}
 
 			property := &Property{
 				entity: entity,
-				Name:   f.Names[0].Name,
+				Name:   fieldName,
+				Path:   fieldName,
 			}
 
 			if f.Tag != nil {
@@ -163,6 +376,30 @@ func (binding *Binding) createEntityFromAst(node ast.Node) (err error) {
 				continue
 			}
 
+			// anonymous embedded fields and fields tagged `inline`/`flatten` are flattened:
+			// their own properties are emitted on the outer entity with a dotted ObName,
+			// read/written through the parent Go field path
+			if isEmbedded || property.Annotations["inline"] != nil || property.Annotations["flatten"] != nil {
+				var nestedTypeName = fieldName
+				if !isEmbedded {
+					name, ok := embeddedTypeName(f.Type)
+					if !ok {
+						return propertyError(fmt.Errorf("inline/flatten annotation requires a named struct type"), property)
+					}
+					nestedTypeName = name
+				}
+
+				if property.Annotations["id"] != nil || property.Annotations["link"] != nil {
+					return propertyError(fmt.Errorf("id/link annotations are not allowed on an embedded/inline field"), property)
+				}
+
+				if err = binding.embedStruct(entity, propertiesByName, fieldName, nestedTypeName,
+					map[string]bool{entity.Name: true}); err != nil {
+					return propertyError(err, property)
+				}
+				continue
+			}
+
 			if err = property.setType(f.Type); err != nil {
 				return propertyError(err, property)
 			}
@@ -207,9 +444,44 @@ func (binding *Binding) createEntityFromAst(node ast.Node) (err error) {
 		return fmt.Errorf("there are no properties in the entity %s", entity.Name)
 	}
 
+	for _, directive := range compositeIndexDirectives {
+		var compositeIndex = &CompositeIndex{Unique: directive.unique}
+		var seen = make(map[string]bool)
+
+		for _, rawName := range directive.propertyNames {
+			var name = strings.TrimSpace(rawName)
+			if name == "" {
+				return fmt.Errorf("composite index on entity %s has an empty property name", entity.Name)
+			}
+
+			var lowerName = strings.ToLower(name)
+			if seen[lowerName] {
+				return fmt.Errorf("composite index on entity %s lists property %s twice", entity.Name, name)
+			}
+			seen[lowerName] = true
+
+			property, err := entity.findPropertyByName(name)
+			if err != nil {
+				return err
+			}
+			if property == nil {
+				return fmt.Errorf("composite index on entity %s references unknown property %s",
+					entity.Name, name)
+			}
+
+			compositeIndex.Properties = append(compositeIndex.Properties, property)
+		}
+
+		entity.CompositeIndexes = append(entity.CompositeIndexes, compositeIndex)
+	}
+
 	if entity.IdProperty == nil {
-		// try to find an ID property by name
+		// try to find an ID property by name - nested/flattened properties (Path != Name)
+		// don't count, a field named Id inside an embedded struct is not the entity's ID
 		for _, property := range entity.Properties {
+			if property.Path != property.Name {
+				continue
+			}
 			if strings.ToLower(property.Name) == "id" && strings.ToLower(property.GoType) == "uint64" {
 				if entity.IdProperty == nil {
 					entity.IdProperty = property
@@ -232,12 +504,123 @@ func (binding *Binding) createEntityFromAst(node ast.Node) (err error) {
 	return nil
 }
 
+// embedStruct resolves the struct type named nestedTypeName and recursively
+// appends its fields to entity as flattened properties, prefixing ObName with
+// goFieldPath and dotting into it for the Go field path read/written by the
+// template (e.g. "address.city" / "Address.City"). visited guards against
+// recursive embedding (A embeds B embeds A).
+func (binding *Binding) embedStruct(entity *Entity, propertiesByName map[string]bool, goFieldPath string,
+	nestedTypeName string, visited map[string]bool) error {
+	if visited[nestedTypeName] {
+		return fmt.Errorf("recursive embedding of %s", nestedTypeName)
+	}
+	visited[nestedTypeName] = true
+
+	structType, known := binding.structTypes[nestedTypeName]
+	if !known {
+		return fmt.Errorf("can't resolve embedded/inline type %s", nestedTypeName)
+	}
+
+	for _, f := range structType.Fields.List {
+		var isEmbedded = len(f.Names) == 0
+		var fieldName string
+
+		if isEmbedded {
+			name, ok := embeddedTypeName(f.Type)
+			if !ok {
+				return fmt.Errorf("embedded struct %s has an anonymous field of an unsupported type", nestedTypeName)
+			}
+			fieldName = name
+		} else if len(f.Names) == 1 {
+			fieldName = f.Names[0].Name
+		} else {
+			return fmt.Errorf("embedded struct %s has a field with an invalid number of names, one expected, got %v",
+				nestedTypeName, len(f.Names))
+		}
+
+		var fieldPath = goFieldPath + "." + fieldName
+
+		property := &Property{
+			entity: entity,
+			Name:   fieldName,
+			Path:   fieldPath,
+		}
+
+		var propertyError = func(err error) error {
+			return fmt.Errorf("%s on property %s, entity %s", err, fieldPath, entity.Name)
+		}
+
+		if f.Tag != nil {
+			if err := property.setAnnotations(f.Tag.Value); err != nil {
+				return propertyError(err)
+			}
+		}
+
+		if property.Annotations["transient"] != nil {
+			continue
+		}
+
+		if property.Annotations["id"] != nil || property.Annotations["link"] != nil {
+			return propertyError(fmt.Errorf("id/link annotations are not allowed on a nested property"))
+		}
+
+		if isEmbedded || property.Annotations["inline"] != nil || property.Annotations["flatten"] != nil {
+			var nestedName = fieldName
+			if !isEmbedded {
+				name, ok := embeddedTypeName(f.Type)
+				if !ok {
+					return propertyError(fmt.Errorf("inline/flatten annotation requires a named struct type"))
+				}
+				nestedName = name
+			}
+
+			if err := binding.embedStruct(entity, propertiesByName, fieldPath, nestedName, visited); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := property.setType(f.Type); err != nil {
+			return propertyError(err)
+		}
+
+		if err := property.setObFlags(*f); err != nil {
+			return propertyError(err)
+		}
+
+		if property.Annotations["nameindb"] != nil {
+			if len(property.Annotations["nameindb"].Value) == 0 {
+				return propertyError(fmt.Errorf("nameInDb annotation value must not be empty"))
+			}
+			property.ObName = strings.ToLower(goFieldPath) + "." + property.Annotations["nameindb"].Value
+		} else {
+			property.ObName = strings.ToLower(goFieldPath) + "." + strings.ToLower(fieldName[:1]) + fieldName[1:]
+		}
+
+		var realObName = strings.ToLower(property.ObName)
+		if propertiesByName[realObName] {
+			return propertyError(fmt.Errorf("duplicate name (note that property names are case insensitive)"))
+		}
+		propertiesByName[realObName] = true
+
+		entity.Properties = append(entity.Properties, property)
+	}
+
+	delete(visited, nestedTypeName)
+	return nil
+}
+
 // Supported annotations:
 // id
 // index (value|hash|hash64)
 // unique
 // nameInDb
 // transient
+// noindex (vector properties only - skip the per-element index)
+// inline / flatten (named field only - flatten a nested struct's properties into the parent entity)
+// date-nano (date fields only - store as 64-bit nanoseconds instead of the default milliseconds)
+// converter (+ type) - store via user-provided ToDb/FromDb conversion functions
+// fts (String/StringVector only - full-text index, queried via a generated SearchFullText helper)
 func (property *Property) setAnnotations(tags string) error {
 	if len(tags) > 1 && tags[0] == tags[len(tags)-1] && (tags[0] == '`' || tags[0] == '"') {
 		tags = tags[1 : len(tags)-1]
@@ -283,10 +666,92 @@ func (property *Property) setAnnotations(tags string) error {
 	return nil
 }
 
+// sliceVectorTypes maps slice-typed Go expressions to the ObjectBox vector
+// type used to persist them without a wrapper entity.
+var sliceVectorTypes = map[string]string{
+	"[]string":  "StringVector",
+	"[]int32":   "IntVector",
+	"[]int64":   "LongVector",
+	"[]uint32":  "IntVector",
+	"[]uint64":  "LongVector",
+	"[]float32": "FloatVector",
+	"[]float64": "DoubleVector",
+}
+
+// obTypeFbTypes maps an ObjectBox type name (as it may be declared via the
+// `type` annotation) to the FlatBuffers type used to read/write it.
+var obTypeFbTypes = map[string]string{
+	"String":       "UOffsetT",
+	"Long":         "Int64",
+	"Int":          "Int32",
+	"Short":        "Int16",
+	"Byte":         "Int8",
+	"ByteVector":   "UOffsetT",
+	"Double":       "Float64",
+	"Float":        "Float32",
+	"Bool":         "Bool",
+	"Date":         "Int64",
+	"StringVector": "UOffsetT",
+	"IntVector":    "UOffsetT",
+	"LongVector":   "UOffsetT",
+	"FloatVector":  "UOffsetT",
+	"DoubleVector": "UOffsetT",
+}
+
+// setConverterType handles a property annotated with `converter`, bypassing
+// the built-in Go type table entirely: the on-disk representation is taken
+// from the required `type` annotation instead of being inferred from goType.
+func (property *Property) setConverterType(goType string) error {
+	// date/date-nano/link all exist to derive ObType from the underlying Go type - converter
+	// already declares ObType explicitly via the type annotation, so combining them is rejected
+	// rather than one silently winning over the other
+	if property.Annotations["date"] != nil || property.Annotations["date-nano"] != nil {
+		return fmt.Errorf("date/date-nano annotations can't be combined with converter - " +
+			"declare the stored type via the type annotation instead")
+	}
+	if property.Annotations["link"] != nil {
+		return fmt.Errorf("link annotation can't be combined with converter")
+	}
+
+	var parts = strings.Split(property.Annotations["converter"].Value, ",")
+	if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+		return fmt.Errorf("converter annotation must have the form converter:\"ToDb,FromDb\"")
+	}
+
+	property.Converter = &Converter{
+		ToDb:   strings.TrimSpace(parts[0]),
+		FromDb: strings.TrimSpace(parts[1]),
+	}
+
+	if property.Annotations["type"] == nil || property.Annotations["type"].Value == "" {
+		return fmt.Errorf("type annotation is required when using converter on type %s", goType)
+	}
+
+	var obType = property.Annotations["type"].Value
+	fbType, known := obTypeFbTypes[obType]
+	if !known {
+		return fmt.Errorf("unknown type %s declared via the type annotation", obType)
+	}
+
+	property.ObType = obType
+	property.FbType = fbType
+
+	if property.Annotations["noindex"] != nil && !strings.HasSuffix(property.ObType, "Vector") {
+		return fmt.Errorf("noindex annotation is only valid on vector properties")
+	}
+
+	return nil
+}
+
 func (property *Property) setType(t ast.Expr) error {
 	property.GoType = types.ExprString(t)
 
 	ts := property.GoType
+
+	if property.Annotations["converter"] != nil {
+		return property.setConverterType(ts)
+	}
+
 	if property.GoType == "string" {
 		property.ObType = "String"
 		property.FbType = "UOffsetT"
@@ -329,18 +794,39 @@ func (property *Property) setType(t ast.Expr) error {
 	} else if ts == "bool" {
 		property.ObType = "Bool"
 		property.FbType = "Bool"
+	} else if vectorObType, ok := sliceVectorTypes[ts]; ok {
+		property.ObType = vectorObType
+		property.FbType = "UOffsetT"
+	} else if ts == "time.Time" || ts == "*time.Time" {
+		// stored as a millisecond (or, with date-nano, nanosecond) Unix timestamp
+		property.ObType = "Date"
+		property.FbType = "Int64"
 	} else {
 		return fmt.Errorf("unknown type %s", ts)
 	}
 
+	if property.Annotations["noindex"] != nil && !strings.HasSuffix(property.ObType, "Vector") {
+		return fmt.Errorf("noindex annotation is only valid on vector properties")
+	}
+
 	if property.Annotations["date"] != nil {
-		if property.ObType != "Long" {
+		if property.ObType == "Date" {
+			// already a native time.Time field, the annotation only carries a resolution value
+		} else if property.ObType != "Long" {
 			return fmt.Errorf("invalid underlying type (%s) for date field", property.ObType)
 		} else {
 			property.ObType = "Date"
 		}
 	}
 
+	if property.Annotations["date-nano"] != nil ||
+		(property.Annotations["date"] != nil && strings.ToLower(property.Annotations["date"].Value) == "nanos") {
+		if property.ObType != "Date" {
+			return fmt.Errorf("date-nano annotation is only valid on date fields")
+		}
+		property.DateNano = true
+	}
+
 	if property.Annotations["link"] != nil {
 		if property.ObType != "Long" {
 			return fmt.Errorf("invalid underlying type (%s) for relation field", property.ObType)
@@ -369,6 +855,15 @@ func (property *Property) setIndex() error {
 }
 
 func (property *Property) setObFlags(f ast.Field) error {
+	// `index:"fts"` is an alternate spelling of the standalone `fts` annotation
+	if property.Annotations["index"] != nil && strings.ToLower(property.Annotations["index"].Value) == "fts" {
+		if property.Annotations["fts"] != nil {
+			return fmt.Errorf("at most one fts index type may be declared per property")
+		}
+		property.Annotations["fts"] = &Annotation{}
+		delete(property.Annotations, "index")
+	}
+
 	if property.Annotations["id"] != nil {
 		property.addObFlag("ID")
 	}
@@ -408,6 +903,26 @@ func (property *Property) setObFlags(f ast.Field) error {
 		}
 	}
 
+	if property.Annotations["noindex"] != nil {
+		property.addObFlag("VECTOR_NOINDEX")
+	}
+
+	if property.Annotations["fts"] != nil {
+		if property.ObType != "String" && property.ObType != "StringVector" {
+			return fmt.Errorf("fts annotation is only valid on String and StringVector properties")
+		}
+
+		if idx := property.Annotations["index"]; idx != nil {
+			switch strings.ToLower(idx.Value) {
+			case "hash", "hash64":
+				return fmt.Errorf("fts annotation cannot be combined with a hash/hash64 index")
+			}
+		}
+
+		property.addObFlag("FTS_INDEX")
+		property.entity.FullTextProperties = append(property.entity.FullTextProperties, property)
+	}
+
 	return nil
 }
 
@@ -416,7 +931,21 @@ func (property *Property) setObFlags(f ast.Field) error {
 func (binding *Binding) UsesFbUtils() bool {
 	for _, entity := range binding.Entities {
 		for _, property := range entity.Properties {
-			if strings.ToLower(property.ObType) == "string" || strings.ToLower(property.ObType) == "bytevector" {
+			if strings.ToLower(property.ObType) == "string" || strings.ToLower(property.ObType) == "bytevector" ||
+				strings.HasSuffix(property.ObType, "Vector") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// called from the template
+// avoid GO error "imported and not used" when an entity has a time.Time property
+func (binding *Binding) UsesTimePackage() bool {
+	for _, entity := range binding.Entities {
+		for _, property := range entity.Properties {
+			if property.GoType == "time.Time" || property.GoType == "*time.Time" {
 				return true
 			}
 		}
@@ -436,6 +965,17 @@ func (entity *Entity) HasNonIdProperty() bool {
 	return false
 }
 
+// called from the template
+func (entity *Entity) HasCompositeIndexes() bool {
+	return len(entity.CompositeIndexes) > 0
+}
+
+// called from the template to decide whether to emit the Put/Remove hooks
+// into objectbox.FullTextIndex and the entity's SearchFullText(query) helper
+func (entity *Entity) HasFullTextIndex() bool {
+	return len(entity.FullTextProperties) > 0
+}
+
 // calculates flatbuffers vTableOffset
 // called from the template
 func (property *Property) FbvTableOffset() uint16 {
@@ -0,0 +1,293 @@
+/*
+ * Copyright 2018 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generator
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+)
+
+// newTestBinding parses src (a package body, without the `package` clause) and
+// runs it through the same two-pass struct-type collection + entity loading
+// createFromAst does, without needing the generator's *file wrapper.
+func newTestBinding(t *testing.T, src string) (*Binding, error) {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	astFile, err := parser.ParseFile(fset, "test.go", "package test\n"+src, 0)
+	if err != nil {
+		t.Fatalf("failed to parse test source: %v", err)
+	}
+
+	var binding = &Binding{}
+	binding.structTypes = make(map[string]*ast.StructType)
+	ast.Inspect(astFile, func(n ast.Node) bool {
+		return binding.structTypeCollector(n)
+	})
+	binding.currentEntityName = ""
+
+	binding.embeddedOnly = binding.findEmbeddedOnlyTypes()
+
+	ast.Inspect(astFile, func(n ast.Node) bool {
+		return binding.entityLoader(n)
+	})
+
+	return binding, binding.err
+}
+
+func TestEmbeddedStructFlattening(t *testing.T) {
+	binding, err := newTestBinding(t, `
+type Address struct {
+	City string
+}
+
+type Foo struct {
+	Id   uint64  `+"`id`"+`
+	Home Address `+"`inline`"+`
+}
+`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(binding.Entities) != 1 {
+		t.Fatalf("expected exactly one entity (Address must not become a standalone entity), got %d: %v",
+			len(binding.Entities), entityNames(binding.Entities))
+	}
+
+	var foo = binding.Entities[0]
+	if foo.Name != "Foo" {
+		t.Fatalf("expected entity Foo, got %s", foo.Name)
+	}
+
+	if len(foo.Properties) != 2 {
+		t.Fatalf("expected 2 properties (Id, home.city), got %d", len(foo.Properties))
+	}
+
+	var city = foo.Properties[1]
+	if city.ObName != "home.city" {
+		t.Errorf("expected ObName home.city, got %s", city.ObName)
+	}
+	if city.Path != "Home.City" {
+		t.Errorf("expected Path Home.City, got %s", city.Path)
+	}
+}
+
+func TestEmbeddedOnlyTypeIsNotPromotedToEntity(t *testing.T) {
+	binding, err := newTestBinding(t, `
+type Address struct {
+	Id   uint64
+	City string
+}
+
+type Foo struct {
+	Id   uint64  `+"`id`"+`
+	Home Address `+"`inline`"+`
+}
+`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(binding.Entities) != 1 {
+		t.Fatalf("expected only Foo to become an entity, got %d: %v",
+			len(binding.Entities), entityNames(binding.Entities))
+	}
+}
+
+func TestNestedIdByConventionIsIgnored(t *testing.T) {
+	_, err := newTestBinding(t, `
+type Address struct {
+	Id   uint64
+	City string
+}
+
+type Foo struct {
+	Name string
+	Home Address `+"`inline`"+`
+}
+`)
+	if err == nil || !strings.Contains(err.Error(), "id field is missing on entity Foo") {
+		t.Fatalf("expected Foo to be missing an id (Address.Id must not satisfy it by convention), got %v", err)
+	}
+}
+
+func TestEmbeddedCycleDetection(t *testing.T) {
+	// B and C only ever appear embedded into each other and into the real
+	// entity A - A itself is never embedded, so it's still processed and its
+	// flattening walk must detect the B -> C -> B cycle.
+	_, err := newTestBinding(t, `
+type C struct {
+	B B `+"`inline`"+`
+}
+
+type B struct {
+	C C `+"`inline`"+`
+}
+
+type A struct {
+	Id uint64 `+"`id`"+`
+	B  B      `+"`inline`"+`
+}
+`)
+	if err == nil || !strings.Contains(err.Error(), "recursive embedding") {
+		t.Fatalf("expected a recursive embedding error, got %v", err)
+	}
+}
+
+func TestCompositeIndexAmbiguousBareName(t *testing.T) {
+	_, err := newTestBinding(t, `
+type Address struct {
+	City string
+}
+
+type Foo struct {
+	Id   uint64  `+"`id`"+`
+	Home Address `+"`inline`"+`
+	Ship Address `+"`inline`"+`
+	_    struct{} `+"`unique:\"City\"`"+`
+}
+`)
+	if err == nil || !strings.Contains(err.Error(), "ambiguous") {
+		t.Fatalf("expected an ambiguous property name error, got %v", err)
+	}
+}
+
+func TestCompositeIndexFullyQualifiedName(t *testing.T) {
+	binding, err := newTestBinding(t, `
+type Address struct {
+	City string
+}
+
+type Foo struct {
+	Id   uint64  `+"`id`"+`
+	Home Address `+"`inline`"+`
+	Ship Address `+"`inline`"+`
+	_    struct{} `+"`unique:\"home.city,ship.city\"`"+`
+}
+`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(binding.Entities) != 1 || len(binding.Entities[0].CompositeIndexes) != 1 {
+		t.Fatalf("expected a single entity with a single composite index")
+	}
+
+	if props := binding.Entities[0].CompositeIndexes[0].Properties; len(props) != 2 {
+		t.Fatalf("expected the composite index to cover 2 properties, got %d", len(props))
+	}
+}
+
+func TestConverterRejectsNoindexOnNonVector(t *testing.T) {
+	_, err := newTestBinding(t, `
+type Foo struct {
+	Id   uint64 `+"`id`"+`
+	Code int64  `+"`converter:\"pkg.ToDb,pkg.FromDb\" type:\"Long\" noindex`"+`
+}
+`)
+	if err == nil || !strings.Contains(err.Error(), "noindex annotation is only valid on vector properties") {
+		t.Fatalf("expected a noindex-is-vector-only error, got %v", err)
+	}
+}
+
+func TestConverterRejectsDate(t *testing.T) {
+	_, err := newTestBinding(t, `
+type Foo struct {
+	Id    uint64 `+"`id`"+`
+	Stamp int64  `+"`converter:\"pkg.ToDb,pkg.FromDb\" type:\"Long\" date`"+`
+}
+`)
+	if err == nil || !strings.Contains(err.Error(), "date/date-nano annotations can't be combined with converter") {
+		t.Fatalf("expected a date+converter conflict error, got %v", err)
+	}
+}
+
+func TestConverterRejectsLink(t *testing.T) {
+	_, err := newTestBinding(t, `
+type Foo struct {
+	Id    uint64 `+"`id`"+`
+	Owner int64  `+"`converter:\"pkg.ToDb,pkg.FromDb\" type:\"Long\" link:\"Owner\"`"+`
+}
+`)
+	if err == nil || !strings.Contains(err.Error(), "link annotation can't be combined with converter") {
+		t.Fatalf("expected a link+converter conflict error, got %v", err)
+	}
+}
+
+func TestFtsRejectsNonStringProperty(t *testing.T) {
+	_, err := newTestBinding(t, `
+type Foo struct {
+	Id    uint64 `+"`id`"+`
+	Count int64  `+"`fts`"+`
+}
+`)
+	if err == nil || !strings.Contains(err.Error(), "fts annotation is only valid on String and StringVector properties") {
+		t.Fatalf("expected an fts-is-string-only error, got %v", err)
+	}
+}
+
+func TestFtsRejectsHashIndex(t *testing.T) {
+	_, err := newTestBinding(t, `
+type Foo struct {
+	Id    uint64 `+"`id`"+`
+	Title string `+"`fts index:\"hash\"`"+`
+}
+`)
+	if err == nil || !strings.Contains(err.Error(), "fts annotation cannot be combined with a hash/hash64 index") {
+		t.Fatalf("expected an fts+hash conflict error, got %v", err)
+	}
+}
+
+func TestFtsRejectsDuplicateDeclaration(t *testing.T) {
+	_, err := newTestBinding(t, `
+type Foo struct {
+	Id    uint64 `+"`id`"+`
+	Title string `+"`fts index:\"fts\"`"+`
+}
+`)
+	if err == nil || !strings.Contains(err.Error(), "at most one fts index type may be declared per property") {
+		t.Fatalf("expected a duplicate-fts-declaration error, got %v", err)
+	}
+}
+
+func TestFtsOnStringPropertyIsAccepted(t *testing.T) {
+	binding, err := newTestBinding(t, `
+type Foo struct {
+	Id    uint64 `+"`id`"+`
+	Title string `+"`fts`"+`
+}
+`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(binding.Entities) != 1 || len(binding.Entities[0].FullTextProperties) != 1 {
+		t.Fatalf("expected Title to be registered as a full-text property")
+	}
+}
+
+func entityNames(entities []*Entity) []string {
+	var names []string
+	for _, e := range entities {
+		names = append(names, e.Name)
+	}
+	return names
+}